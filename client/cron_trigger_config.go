@@ -0,0 +1,13 @@
+package client
+
+// CronTriggerConfig holds the overrides for cron trigger validation set via the provider's
+// `cron_trigger { message_pattern = "..."; cron_spec = "..." }` block. It's package-level rather
+// than a Client field because the validation it drives runs from schema.ValidateDiagFunc
+// callbacks, which aren't handed the provider meta.
+type CronTriggerConfig struct {
+	MessagePattern string
+	CronSpec       string
+}
+
+// CronTrigger is populated once by the provider's ConfigureContextFunc.
+var CronTrigger CronTriggerConfig
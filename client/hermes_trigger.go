@@ -0,0 +1,38 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// HermesPipelineTrigger is one entry returned by ListHermesTriggersByPipeline. It's a distinct
+// type from HermesTrigger (the shape of a single lookup-by-event result) because the
+// list-by-pipeline endpoint additionally reports each trigger's Type, which callers need to
+// filter out non-cron triggers.
+type HermesPipelineTrigger struct {
+	Type  string `json:"type"`
+	Event string `json:"event"`
+}
+
+// ListHermesTriggersByPipeline lists every trigger currently bound to a pipeline, regardless of
+// whether Terraform created it - used to discover triggers ahead of an `import`.
+func (client *Client) ListHermesTriggersByPipeline(pipelineID string) ([]HermesPipelineTrigger, error) {
+	fullPath := fmt.Sprintf("/hermes/pipelines/%s/triggers", pipelineID)
+
+	opts := RequestOptions{
+		Path:   fullPath,
+		Method: "GET",
+	}
+
+	resp, err := client.RequestAPI(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var triggers []HermesPipelineTrigger
+	if err := json.Unmarshal(resp, &triggers); err != nil {
+		return nil, err
+	}
+
+	return triggers, nil
+}
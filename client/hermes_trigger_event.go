@@ -0,0 +1,32 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetHermesTriggerEventByURI fetches the full trigger event (expression, message, branch,
+// variables, ...) by its event URI. Unlike GetHermesTriggerByEventAndPipeline, which only
+// resolves the colon-delimited event string, this returns the values Hermes stored for the
+// event, which is the only place branch/variables round-trip through.
+func (client *Client) GetHermesTriggerEventByURI(uri string) (*HermesTriggerEvent, error) {
+	fullPath := fmt.Sprintf("/hermes/events/%s", url.PathEscape(uri))
+
+	opts := RequestOptions{
+		Path:   fullPath,
+		Method: "GET",
+	}
+
+	resp, err := client.RequestAPI(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var triggerEvent HermesTriggerEvent
+	if err := json.Unmarshal(resp, &triggerEvent); err != nil {
+		return nil, err
+	}
+
+	return &triggerEvent, nil
+}
@@ -0,0 +1,113 @@
+package codefresh
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourcePipelineCronTriggers lists the cron triggers already bound to a pipeline, including
+// ones not created by this resource - e.g. to adopt them into Terraform via `import`.
+func dataSourcePipelineCronTriggers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePipelineCronTriggersRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expression_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"triggers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var pipelineCronTriggerEventRe = regexp.MustCompile("[^:]+:[^:]+:[^:]+:[^:]+")
+
+func dataSourcePipelineCronTriggersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cfClient.Client)
+
+	pipelineID := d.Get("pipeline_id").(string)
+
+	var expressionFilter *regexp.Regexp
+	if raw := d.Get("expression_filter").(string); raw != "" {
+		var err error
+		expressionFilter, err = regexp.Compile(raw)
+		if err != nil {
+			return fmt.Errorf("invalid expression_filter %q: %v", raw, err)
+		}
+	}
+
+	hermesTriggers, err := client.ListHermesTriggersByPipeline(pipelineID)
+	if err != nil {
+		return err
+	}
+
+	triggers := make([]map[string]interface{}, 0, len(hermesTriggers))
+	for _, hermesTrigger := range hermesTriggers {
+		if hermesTrigger.Type != "cron" {
+			continue
+		}
+
+		if !pipelineCronTriggerEventRe.MatchString(hermesTrigger.Event) {
+			continue
+		}
+
+		eventStringAttributes := strings.Split(hermesTrigger.Event, ":")
+		expression := eventStringAttributes[2]
+		message := eventStringAttributes[3]
+
+		if expressionFilter != nil && !expressionFilter.MatchString(expression) {
+			continue
+		}
+
+		triggers = append(triggers, map[string]interface{}{
+			"event":      hermesTrigger.Event,
+			"expression": expression,
+			"message":    message,
+			"uri":        hermesTrigger.Event,
+		})
+	}
+
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i]["uri"].(string) < triggers[j]["uri"].(string)
+	})
+
+	if err := d.Set("triggers", triggers); err != nil {
+		return err
+	}
+
+	d.SetId(pipelineID)
+
+	return nil
+}
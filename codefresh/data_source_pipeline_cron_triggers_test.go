@@ -0,0 +1,76 @@
+package codefresh
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccCodefreshPipelineCronTriggersDataSource_TwoFixtures pre-provisions two cron triggers
+// directly through the raw client (not through the codefresh_pipeline_cron_trigger resource) to
+// exercise the data source's primary use case: discovering triggers Terraform doesn't manage.
+func TestAccCodefreshPipelineCronTriggersDataSource_TwoFixtures(t *testing.T) {
+	testAccPreCheckPipelineCronTrigger(t)
+	pipelineID := os.Getenv("CODEFRESH_TEST_PIPELINE_ID")
+
+	client := testAccProvider.Meta().(*cfClient.Client)
+
+	eventA := testAccProvisionCronTriggerFixture(t, client, pipelineID, "0 0 * * * *", "fixture a")
+	eventB := testAccProvisionCronTriggerFixture(t, client, pipelineID, "0 30 * * * *", "fixture b")
+
+	expectedOrder := []string{eventA, eventB}
+	sort.Strings(expectedOrder)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodefreshPipelineCronTriggersDataSourceConfig(pipelineID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.codefresh_pipeline_cron_triggers.test", "triggers.#", "2"),
+					resource.TestCheckResourceAttr("data.codefresh_pipeline_cron_triggers.test", "triggers.0.uri", expectedOrder[0]),
+					resource.TestCheckResourceAttr("data.codefresh_pipeline_cron_triggers.test", "triggers.1.uri", expectedOrder[1]),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvisionCronTriggerFixture(t *testing.T, client *cfClient.Client, pipelineID, expression, message string) string {
+	t.Helper()
+
+	event, err := client.CreateHermesTriggerEvent(&cfClient.HermesTriggerEvent{
+		Type:   "cron",
+		Kind:   "codefresh",
+		Secret: "!generate",
+		Values: map[string]string{
+			"expression": expression,
+			"message":    message,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to provision fixture trigger event: %v", err)
+	}
+
+	if err := client.CreateHermesTriggerByEventAndPipeline(event, pipelineID); err != nil {
+		t.Fatalf("failed to bind fixture trigger event %q to pipeline %q: %v", event, pipelineID, err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.DeleteHermesTriggerByEventAndPipeline(event, pipelineID)
+	})
+
+	return event
+}
+
+func testAccCodefreshPipelineCronTriggersDataSourceConfig(pipelineID string) string {
+	return fmt.Sprintf(`
+data "codefresh_pipeline_cron_triggers" "test" {
+  pipeline_id = %q
+}
+`, pipelineID)
+}
@@ -0,0 +1,79 @@
+package codefresh
+
+import (
+	"context"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Provider returns the codefresh Terraform provider. It registers the cron trigger and pipeline
+// schedule resources and the cron triggers data source.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CODEFRESH_API_URL", "https://g.codefresh.io"),
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CODEFRESH_API_KEY", nil),
+			},
+			"cron_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cron_spec": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "six_field",
+							ValidateFunc: validation.StringInSlice([]string{"six_field", "standard"}, false),
+						},
+					},
+				},
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"codefresh_pipeline_cron_trigger": resourcePipelineCronTrigger(),
+			"codefresh_pipeline_schedule":     resourcePipelineSchedule(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"codefresh_pipeline_cron_triggers": dataSourcePipelineCronTriggers(),
+		},
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client := cfClient.NewClient(d.Get("api_url").(string), d.Get("token").(string))
+
+	var diags diag.Diagnostics
+
+	if raw, ok := d.GetOk("cron_trigger"); ok {
+		block := raw.([]interface{})[0].(map[string]interface{})
+
+		cfClient.CronTrigger.CronSpec = block["cron_spec"].(string)
+
+		if pattern := block["message_pattern"].(string); pattern != "" {
+			cfClient.CronTrigger.MessagePattern = pattern
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "cron_trigger.message_pattern overridden.",
+				Detail:   "The default message_pattern is tuned to a known Hermes schema revision; a custom pattern may still be rejected by the Codefresh API if it's looser than what the server accepts.",
+			})
+		}
+	}
+
+	return client, diags
+}
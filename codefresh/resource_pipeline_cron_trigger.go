@@ -2,7 +2,9 @@ package codefresh
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
 	"regexp"
 	"strings"
 
@@ -14,6 +16,69 @@ import (
 	"github.com/robfig/cron"
 )
 
+// reservedCronTriggerVariables collide with values Codefresh injects into every build and so
+// cannot be set through the `variables` map.
+var reservedCronTriggerVariables = map[string]bool{
+	"CF_BRANCH":   true,
+	"CF_REVISION": true,
+}
+
+var cronTriggerVariableNameRe = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// defaultCronTriggerMessagePattern was scraped from a specific commit of the Hermes JSON schema;
+// it's the default unless overridden via the provider's `cron_trigger { message_pattern = "..." }`.
+// https://github.com/codefresh-io/hermes/blob/6d75b347cb8ff471ce970a766b2285788e5e19fe/pkg/backend/dev_compose_types.json#L226
+const defaultCronTriggerMessagePattern = `^[a-zA-Z0-9_+\s-#?.:]{2,128}$`
+
+func cronTriggerMessagePattern() *regexp.Regexp {
+	pattern := cfClient.CronTrigger.MessagePattern
+	if pattern == "" {
+		pattern = defaultCronTriggerMessagePattern
+	}
+
+	return regexp.MustCompile(pattern)
+}
+
+// cronTriggerExpressionParser defaults to the 6-field cron format, with ability to use
+// descriptors (e.g. @yearly); `cron_spec = "standard"` switches it to the 5-field UNIX format.
+// Shared with resourcePipelineSchedule, which creates the same kind of Hermes cron trigger events.
+func cronTriggerExpressionParser() cron.Parser {
+	if cfClient.CronTrigger.CronSpec == "standard" {
+		return cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	}
+
+	return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+}
+
+func validateCronExpression(v interface{}, path cty.Path) (diags diag.Diagnostics) {
+	expression := v.(string)
+
+	if _, err := cronTriggerExpressionParser().Parse(expression); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid cron expression.",
+			Detail:   fmt.Sprintf("The cron expression %q is invalid: %s", expression, err),
+		})
+	}
+
+	return
+}
+
+func validateCronMessage(v interface{}, path cty.Path) (diags diag.Diagnostics) {
+	message := v.(string)
+	pattern := cronTriggerMessagePattern()
+
+	if !pattern.MatchString(message) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid message.",
+			Detail:   fmt.Sprintf("The message %q is invalid (must match %q).", message, pattern.String()),
+		})
+	}
+
+	return
+}
+
 func resourcePipelineCronTrigger() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePipelineCronTriggerCreate,
@@ -42,56 +107,54 @@ func resourcePipelineCronTrigger() *schema.Resource {
 				Required: true,
 			},
 			"expression": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateDiagFunc: func(v interface{}, path cty.Path) (diags diag.Diagnostics) {
-					expression := v.(string)
-
-					// Cron expression requirements: 6 fields, with ability to use descriptors (e.g. @yearly)
-					parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
-					if _, err := parser.Parse(expression); err != nil {
-						diags = append(diags, diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  "Invalid cron expression.",
-							Detail:   fmt.Sprintf("The cron expression %q is invalid: %s", expression, err),
-						})
-					}
-
-					return
-				},
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCronExpression,
 			},
 			"message": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCronMessage,
+			},
+			"branch": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Default:  "master",
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 				ValidateDiagFunc: func(v interface{}, path cty.Path) (diags diag.Diagnostics) {
-					message := v.(string)
-
-					// https://github.com/codefresh-io/hermes/blob/6d75b347cb8ff471ce970a766b2285788e5e19fe/pkg/backend/dev_compose_types.json#L226
-					re := regexp.MustCompile(`^[a-zA-Z0-9_+\s-#?.:]{2,128}$`)
-
-					if !re.MatchString(message) {
-						diags = append(diags, diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  "Invalid message.",
-							Detail:   fmt.Sprintf("The message %q is invalid (must match %q).", message, re.String()),
-						})
+					for key := range v.(map[string]interface{}) {
+						if !cronTriggerVariableNameRe.MatchString(key) {
+							diags = append(diags, diag.Diagnostic{
+								Severity: diag.Error,
+								Summary:  "Invalid variable name.",
+								Detail:   fmt.Sprintf("The variable name %q is invalid (must match %q).", key, cronTriggerVariableNameRe.String()),
+							})
+						}
+
+						if reservedCronTriggerVariables[key] {
+							diags = append(diags, diag.Diagnostic{
+								Severity: diag.Error,
+								Summary:  "Reserved variable name.",
+								Detail:   fmt.Sprintf("The variable name %q is reserved by Codefresh and collides with the %q attribute.", key, "branch"),
+							})
+						}
 					}
 
 					return
 				},
 			},
 		},
-		// Force new resource if any field changes. This is because the Codefresh API does not support updating cron triggers.
+		// The Codefresh API has no endpoint to rebind a trigger-pipeline association to a
+		// different pipeline, so pipeline_id still forces replacement. expression/message are
+		// handled in-place by Update via a create-then-delete against Hermes.
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("pipeline_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return true
 			}),
-			customdiff.ForceNewIfChange("expression", func(ctx context.Context, old, new, meta interface{}) bool {
-				return true
-			}),
-			customdiff.ForceNewIfChange("message", func(ctx context.Context, old, new, meta interface{}) bool {
-				return true
-			}),
 		),
 	}
 }
@@ -99,14 +162,16 @@ func resourcePipelineCronTrigger() *schema.Resource {
 func resourcePipelineCronTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cfClient.Client)
 
+	values, err := cronTriggerEventValues(d)
+	if err != nil {
+		return err
+	}
+
 	eventString, err := client.CreateHermesTriggerEvent(&cfClient.HermesTriggerEvent{
 		Type:   "cron",
 		Kind:   "codefresh",
 		Secret: "!generate",
-		Values: map[string]string{
-			"expression": d.Get("expression").(string),
-			"message":    d.Get("message").(string),
-		},
+		Values: values,
 	})
 	if err != nil {
 		return err
@@ -141,12 +206,52 @@ func resourcePipelineCronTriggerRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return nil
+	// branch and variables aren't part of the colon-delimited event string, so they have to be
+	// recovered from the trigger event itself.
+	hermesTriggerEvent, err := client.GetHermesTriggerEventByURI(hermesTrigger.Event)
+	if err != nil {
+		return err
+	}
+
+	return mapHermesTriggerEventValuesToResource(hermesTriggerEvent, d)
 }
 
 func resourcePipelineCronTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
-	// see notes in resourcePipelineCronTrigger()
-	return fmt.Errorf("cron triggers cannot be updated")
+	client := meta.(*cfClient.Client)
+
+	oldEvent := d.Id()
+	pipelineID := d.Get("pipeline_id").(string)
+
+	values, err := cronTriggerEventValues(d)
+	if err != nil {
+		return err
+	}
+
+	// Hermes cannot mutate an existing trigger event in place, so an update is implemented as
+	// create-new, bind-new, then delete-old. Ordering it this way means a pipeline is never left
+	// without a trigger, even if the delete of the old event fails part-way through.
+	newEvent, err := client.CreateHermesTriggerEvent(&cfClient.HermesTriggerEvent{
+		Type:   "cron",
+		Kind:   "codefresh",
+		Secret: "!generate",
+		Values: values,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create replacement cron trigger event: %v", err)
+	}
+
+	if err := client.CreateHermesTriggerByEventAndPipeline(newEvent, pipelineID); err != nil {
+		return fmt.Errorf("failed to bind replacement cron trigger event %q to pipeline %q: %v", newEvent, pipelineID, err)
+	}
+
+	d.SetId(newEvent)
+
+	if err := client.DeleteHermesTriggerByEventAndPipeline(oldEvent, pipelineID); err != nil {
+		log.Printf("[WARN] failed to delete superseded cron trigger event %q on pipeline %q after creating replacement %q: %v; "+
+			"both triggers are now bound to the pipeline, remove %q manually", oldEvent, pipelineID, newEvent, err, oldEvent)
+	}
+
+	return resourcePipelineCronTriggerRead(d, meta)
 }
 
 func resourcePipelineCronTriggerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -180,6 +285,47 @@ func mapPipelineCronTriggerToResource(hermesTrigger *cfClient.HermesTrigger, d *
 	return nil
 }
 
+// cronTriggerEventValues builds the Values payload sent to Hermes, serializing variables as a
+// JSON-encoded blob the way Codefresh's Hermes accepts for cron trigger payloads.
+func cronTriggerEventValues(d *schema.ResourceData) (map[string]string, error) {
+	values := map[string]string{
+		"expression": d.Get("expression").(string),
+		"message":    d.Get("message").(string),
+		"branch":     d.Get("branch").(string),
+	}
+
+	variables := d.Get("variables").(map[string]interface{})
+	if len(variables) > 0 {
+		encoded, err := json.Marshal(variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode cron trigger variables: %v", err)
+		}
+		values["variables"] = string(encoded)
+	}
+
+	return values, nil
+}
+
+// mapHermesTriggerEventValuesToResource recovers branch and variables from the trigger event's
+// Values map, since they aren't part of the colon-delimited event string.
+func mapHermesTriggerEventValuesToResource(hermesTriggerEvent *cfClient.HermesTriggerEvent, d *schema.ResourceData) error {
+	branch := hermesTriggerEvent.Values["branch"]
+	if branch == "" {
+		branch = "master"
+	}
+	d.Set("branch", branch)
+
+	if raw, ok := hermesTriggerEvent.Values["variables"]; ok && raw != "" {
+		variables := map[string]string{}
+		if err := json.Unmarshal([]byte(raw), &variables); err != nil {
+			return fmt.Errorf("failed to decode cron trigger variables %q: %v", raw, err)
+		}
+		d.Set("variables", variables)
+	}
+
+	return nil
+}
+
 func mapResourceToPipelineCronTrigger(d *schema.ResourceData) *cfClient.HermesTrigger {
 
 	triggerId := d.Id()
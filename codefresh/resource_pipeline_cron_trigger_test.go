@@ -0,0 +1,139 @@
+package codefresh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccCodefreshPipelineCronTrigger_UpdateInPlace changes expression/message and asserts that
+// the trigger ID changes (a new Hermes event was created) and that the pipeline is left with
+// exactly one cron trigger bound to it afterwards. The checks run against the state after each
+// apply and do not inspect the plan, so they pass for a destroy-then-create replacement too.
+func TestAccCodefreshPipelineCronTrigger_UpdateInPlace(t *testing.T) {
+	pipelineID := os.Getenv("CODEFRESH_TEST_PIPELINE_ID")
+	resourceName := "codefresh_pipeline_cron_trigger.test"
+	var firstID, secondID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckPipelineCronTrigger(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCodefreshPipelineCronTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodefreshPipelineCronTriggerConfig(pipelineID, "0 0 * * * *", "initial schedule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodefreshPipelineCronTriggerExists(resourceName),
+					testAccSaveCodefreshPipelineCronTriggerID(resourceName, &firstID),
+					resource.TestCheckResourceAttr(resourceName, "expression", "0 0 * * * *"),
+				),
+			},
+			{
+				Config: testAccCodefreshPipelineCronTriggerConfig(pipelineID, "0 30 * * * *", "updated schedule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodefreshPipelineCronTriggerExists(resourceName),
+					testAccSaveCodefreshPipelineCronTriggerID(resourceName, &secondID),
+					resource.TestCheckResourceAttr(resourceName, "expression", "0 30 * * * *"),
+					testAccCheckCodefreshPipelineHasExactlyOneCronTrigger(pipelineID),
+					func(s *terraform.State) error {
+						if firstID == secondID {
+							return fmt.Errorf("expected the trigger event ID to change after an in-place update, got the same ID %q both times", firstID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckPipelineCronTrigger(t *testing.T) {
+	if os.Getenv("CODEFRESH_TEST_PIPELINE_ID") == "" {
+		t.Skip("CODEFRESH_TEST_PIPELINE_ID must be set to an existing pipeline ID to run this acceptance test")
+	}
+}
+
+func testAccCodefreshPipelineCronTriggerConfig(pipelineID, expression, message string) string {
+	return fmt.Sprintf(`
+resource "codefresh_pipeline_cron_trigger" "test" {
+  pipeline_id = %q
+  expression  = %q
+  message     = %q
+}
+`, pipelineID, expression, message)
+}
+
+func testAccCheckCodefreshPipelineCronTriggerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*cfClient.Client)
+		if _, err := client.GetHermesTriggerByEventAndPipeline(rs.Primary.ID, rs.Primary.Attributes["pipeline_id"]); err != nil {
+			return fmt.Errorf("error fetching cron trigger %q: %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSaveCodefreshPipelineCronTriggerID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckCodefreshPipelineHasExactlyOneCronTrigger counts the pipeline's cron triggers once
+// the update has been applied: if the update left the pipeline with zero triggers (or leaked the
+// superseded one), this fails. It does not observe the pipeline while the update is running.
+func testAccCheckCodefreshPipelineHasExactlyOneCronTrigger(pipelineID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*cfClient.Client)
+
+		triggers, err := client.ListHermesTriggersByPipeline(pipelineID)
+		if err != nil {
+			return fmt.Errorf("error listing cron triggers for pipeline %q: %v", pipelineID, err)
+		}
+
+		cronTriggers := 0
+		for _, trigger := range triggers {
+			if trigger.Type == "cron" {
+				cronTriggers++
+			}
+		}
+
+		if cronTriggers != 1 {
+			return fmt.Errorf("expected pipeline %q to have exactly one cron trigger after the update, found %d", pipelineID, cronTriggers)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCodefreshPipelineCronTriggerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cfClient.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "codefresh_pipeline_cron_trigger" {
+			continue
+		}
+
+		if _, err := client.GetHermesTriggerByEventAndPipeline(rs.Primary.ID, rs.Primary.Attributes["pipeline_id"]); err == nil {
+			return fmt.Errorf("cron trigger %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package codefresh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pipelineScheduleEventRe = regexp.MustCompile("[^:]+:[^:]+:[^:]+:[^:]+")
+
+// resourcePipelineSchedule manages a pair of cron triggers on one pipeline - a "deploy" and a
+// "destroy" window - as a single unit, similar to how env0 pairs a "deploy" and a "destroy" cron
+// on one environment. Both are ordinary Hermes cron triggers that run the pipeline; they differ
+// only in their cron expression and message.
+func resourcePipelineSchedule() *schema.Resource {
+	cronWindowSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cron": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validateCronExpression,
+					},
+					"message": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validateCronMessage,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourcePipelineScheduleCreate,
+		Read:   resourcePipelineScheduleRead,
+		Update: resourcePipelineScheduleUpdate,
+		Delete: resourcePipelineScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ",")
+
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOY_EVENT|DESTROY_EVENT,PIPELINE_ID", d.Id())
+				}
+
+				events := idParts[0]
+				pipelineID := idParts[1]
+				if _, _, err := splitPipelineScheduleID(events); err != nil {
+					return nil, err
+				}
+
+				d.SetId(events)
+				d.Set("pipeline_id", pipelineID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deploy":  cronWindowSchema("The cron trigger that runs the pipeline with the deploy message on a schedule."),
+			"destroy": cronWindowSchema("The cron trigger that runs the pipeline with the destroy message on a schedule."),
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			deployCron := d.Get("deploy.0.cron").(string)
+			destroyCron := d.Get("destroy.0.cron").(string)
+
+			if deployCron != "" && deployCron == destroyCron {
+				return fmt.Errorf("deploy and destroy cron expressions must not be the same")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourcePipelineScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cfClient.Client)
+	pipelineID := d.Get("pipeline_id").(string)
+
+	deployEvent, err := createPipelineScheduleWindow(client, pipelineID, d, "deploy")
+	if err != nil {
+		return fmt.Errorf("failed to create deploy cron trigger: %v", err)
+	}
+
+	destroyEvent, err := createPipelineScheduleWindow(client, pipelineID, d, "destroy")
+	if err != nil {
+		// best-effort rollback: the deploy trigger was already created, so tear it down rather
+		// than leaving a half-provisioned schedule behind.
+		if rollbackErr := client.DeleteHermesTriggerByEventAndPipeline(deployEvent, pipelineID); rollbackErr != nil {
+			return fmt.Errorf("failed to create destroy cron trigger: %v (and failed to roll back deploy cron trigger %q: %v)", err, deployEvent, rollbackErr)
+		}
+
+		return fmt.Errorf("failed to create destroy cron trigger: %v", err)
+	}
+
+	d.SetId(pipelineScheduleID(deployEvent, destroyEvent))
+
+	return resourcePipelineScheduleRead(d, meta)
+}
+
+func resourcePipelineScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cfClient.Client)
+
+	deployEvent, destroyEvent, err := splitPipelineScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	pipelineID := d.Get("pipeline_id").(string)
+
+	deployTrigger, err := client.GetHermesTriggerByEventAndPipeline(deployEvent, pipelineID)
+	if err != nil {
+		return err
+	}
+
+	destroyTrigger, err := client.GetHermesTriggerByEventAndPipeline(destroyEvent, pipelineID)
+	if err != nil {
+		return err
+	}
+
+	if deployTrigger.Event == destroyTrigger.Event {
+		return fmt.Errorf("deploy and destroy cron triggers must not reference the same event (%q)", deployTrigger.Event)
+	}
+
+	if err := setPipelineScheduleWindow(d, "deploy", deployTrigger); err != nil {
+		return err
+	}
+
+	return setPipelineScheduleWindow(d, "destroy", destroyTrigger)
+}
+
+func resourcePipelineScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cfClient.Client)
+	pipelineID := d.Get("pipeline_id").(string)
+
+	deployEvent, destroyEvent, err := splitPipelineScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Mirrors resourcePipelineCronTriggerUpdate: Hermes can't mutate an event in place, so each
+	// changed window is replaced via create-new, bind-new, then delete-old, leaving the pipeline
+	// continuously covered by a trigger for that window.
+	if d.HasChange("deploy") {
+		newDeployEvent, err := createPipelineScheduleWindow(client, pipelineID, d, "deploy")
+		if err != nil {
+			return fmt.Errorf("failed to create replacement deploy cron trigger: %v", err)
+		}
+
+		if err := client.DeleteHermesTriggerByEventAndPipeline(deployEvent, pipelineID); err != nil {
+			log.Printf("[WARN] failed to delete superseded deploy cron trigger event %q on pipeline %q after creating replacement %q: %v; "+
+				"both triggers are now bound to the pipeline, remove %q manually", deployEvent, pipelineID, newDeployEvent, err, deployEvent)
+		}
+
+		deployEvent = newDeployEvent
+		d.SetId(pipelineScheduleID(deployEvent, destroyEvent))
+	}
+
+	if d.HasChange("destroy") {
+		newDestroyEvent, err := createPipelineScheduleWindow(client, pipelineID, d, "destroy")
+		if err != nil {
+			return fmt.Errorf("failed to create replacement destroy cron trigger: %v", err)
+		}
+
+		if err := client.DeleteHermesTriggerByEventAndPipeline(destroyEvent, pipelineID); err != nil {
+			log.Printf("[WARN] failed to delete superseded destroy cron trigger event %q on pipeline %q after creating replacement %q: %v; "+
+				"both triggers are now bound to the pipeline, remove %q manually", destroyEvent, pipelineID, newDestroyEvent, err, destroyEvent)
+		}
+
+		destroyEvent = newDestroyEvent
+		d.SetId(pipelineScheduleID(deployEvent, destroyEvent))
+	}
+
+	return resourcePipelineScheduleRead(d, meta)
+}
+
+func resourcePipelineScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cfClient.Client)
+
+	deployEvent, destroyEvent, err := splitPipelineScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	pipelineID := d.Get("pipeline_id").(string)
+
+	// tear down both events; report partial failures rather than hiding them, so the operator
+	// knows which trigger is still bound to the pipeline.
+	deployErr := client.DeleteHermesTriggerByEventAndPipeline(deployEvent, pipelineID)
+	destroyErr := client.DeleteHermesTriggerByEventAndPipeline(destroyEvent, pipelineID)
+
+	if deployErr != nil || destroyErr != nil {
+		return fmt.Errorf("failed to delete pipeline schedule: deploy: %v, destroy: %v", deployErr, destroyErr)
+	}
+
+	return nil
+}
+
+func createPipelineScheduleWindow(client *cfClient.Client, pipelineID string, d *schema.ResourceData, key string) (string, error) {
+	cron := d.Get(fmt.Sprintf("%s.0.cron", key)).(string)
+	message := d.Get(fmt.Sprintf("%s.0.message", key)).(string)
+
+	event, err := client.CreateHermesTriggerEvent(&cfClient.HermesTriggerEvent{
+		Type:   "cron",
+		Kind:   "codefresh",
+		Secret: "!generate",
+		Values: map[string]string{
+			"expression": cron,
+			"message":    message,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := client.CreateHermesTriggerByEventAndPipeline(event, pipelineID); err != nil {
+		return "", err
+	}
+
+	return event, nil
+}
+
+func setPipelineScheduleWindow(d *schema.ResourceData, key string, hermesTrigger *cfClient.HermesTrigger) error {
+	if !pipelineScheduleEventRe.MatchString(hermesTrigger.Event) {
+		return fmt.Errorf("event string must be in format 'cron:codefresh:[expression]:[message]:[uid]': %s", hermesTrigger.Event)
+	}
+
+	eventStringAttributes := strings.Split(hermesTrigger.Event, ":")
+
+	return d.Set(key, []map[string]interface{}{
+		{
+			"cron":    eventStringAttributes[2],
+			"message": eventStringAttributes[3],
+		},
+	})
+}
+
+func pipelineScheduleID(deployEvent, destroyEvent string) string {
+	return fmt.Sprintf("%s|%s", deployEvent, destroyEvent)
+}
+
+func splitPipelineScheduleID(id string) (deployEvent string, destroyEvent string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected DEPLOY_EVENT|DESTROY_EVENT", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,139 @@
+package codefresh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	cfClient "github.com/codefresh-io/terraform-provider-codefresh/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCodefreshPipelineSchedule_SameCronRejected(t *testing.T) {
+	pipelineID := os.Getenv("CODEFRESH_TEST_PIPELINE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckPipelineCronTrigger(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodefreshPipelineScheduleConfig(pipelineID, "0 0 * * * *", "0 0 * * * *"),
+				ExpectError: regexp.MustCompile(`deploy and destroy cron expressions must not be the same`),
+			},
+		},
+	})
+}
+
+// TestAccCodefreshPipelineSchedule_Import covers the documented import format
+// `deployEvent|destroyEvent,pipelineId`.
+//
+// Note: genuinely forcing the second Hermes create call to fail mid-Create (to exercise the
+// rollback branch in resourcePipelineScheduleCreate) requires fault injection at the HTTP layer
+// that this acceptance-test harness doesn't have, so the rollback path is not exercised here.
+// The CheckDestroy below only proves that no trigger is left bound to the pipeline once a
+// schedule is created successfully and then removed.
+func TestAccCodefreshPipelineSchedule_Import(t *testing.T) {
+	pipelineID := os.Getenv("CODEFRESH_TEST_PIPELINE_ID")
+	resourceName := "codefresh_pipeline_schedule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckPipelineCronTrigger(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCodefreshPipelineScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodefreshPipelineScheduleConfig(pipelineID, "0 0 * * * *", "0 0 1 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodefreshPipelineScheduleExists(resourceName),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s,%s", rs.Primary.ID, rs.Primary.Attributes["pipeline_id"]), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCodefreshPipelineScheduleConfig(pipelineID, deployCron, destroyCron string) string {
+	return fmt.Sprintf(`
+resource "codefresh_pipeline_schedule" "test" {
+  pipeline_id = %q
+
+  deploy {
+    cron    = %q
+    message = "scheduled deploy"
+  }
+
+  destroy {
+    cron    = %q
+    message = "scheduled destroy"
+  }
+}
+`, pipelineID, deployCron, destroyCron)
+}
+
+func testAccCheckCodefreshPipelineScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		deployEvent, destroyEvent, err := splitPipelineScheduleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*cfClient.Client)
+		pipelineID := rs.Primary.Attributes["pipeline_id"]
+
+		if _, err := client.GetHermesTriggerByEventAndPipeline(deployEvent, pipelineID); err != nil {
+			return fmt.Errorf("error fetching deploy cron trigger %q: %v", deployEvent, err)
+		}
+
+		if _, err := client.GetHermesTriggerByEventAndPipeline(destroyEvent, pipelineID); err != nil {
+			return fmt.Errorf("error fetching destroy cron trigger %q: %v", destroyEvent, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCodefreshPipelineScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cfClient.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "codefresh_pipeline_schedule" {
+			continue
+		}
+
+		deployEvent, destroyEvent, err := splitPipelineScheduleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		pipelineID := rs.Primary.Attributes["pipeline_id"]
+
+		if _, err := client.GetHermesTriggerByEventAndPipeline(deployEvent, pipelineID); err == nil {
+			return fmt.Errorf("deploy cron trigger %q still exists", deployEvent)
+		}
+
+		if _, err := client.GetHermesTriggerByEventAndPipeline(destroyEvent, pipelineID); err == nil {
+			return fmt.Errorf("destroy cron trigger %q still exists", destroyEvent)
+		}
+	}
+
+	return nil
+}